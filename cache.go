@@ -0,0 +1,280 @@
+package config
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache is the interface a pluggable cache backend for the typed getters
+// (String, Strings, StringMap, ...) must implement. Install one via
+// WithCache; when none is installed the getters fall back to the
+// unbounded per-type maps (strCache/sArrCache/sMapCache) they always used.
+type Cache interface {
+	// Get returns the cached value for key, and whether it was found
+	// (and not expired).
+	Get(key string) (interface{}, bool)
+	// Set stores val for key. A zero ttl leaves expiry to the
+	// implementation: the built-in TTL cache applies its default TTL, the
+	// built-in LRU cache ignores ttl altogether (its entries only leave
+	// through Delete/Purge/LRU eviction).
+	Set(key string, val interface{}, ttl time.Duration)
+	// Delete removes a single key, if present.
+	Delete(key string)
+	// Purge clears every cached entry.
+	Purge()
+}
+
+// WithCache installs c as the cache backend used by the typed getters.
+// The getters only consult it while caching is on (see EnableCache).
+//
+//	cfg := config.New("app", config.EnableCache, config.WithCache(config.NewLRUCache(500)))
+func WithCache(c Cache) func(*Options) {
+	return func(opts *Options) {
+		opts.Cache = c
+	}
+}
+
+// cacheKey namespaces a raw config key by the getter type that produced
+// it, so String("x") and Strings("x") can't collide in a shared cache.
+func cacheKey(typ, key string) string {
+	return typ + ":" + key
+}
+
+// cacheGet reads through the installed Cache if any, else through the
+// legacy per-type maps.
+func (c *Config) cacheGet(typ, key string) (interface{}, bool) {
+	if !c.opts.EnableCache {
+		return nil, false
+	}
+
+	if c.opts.Cache != nil {
+		return c.opts.Cache.Get(cacheKey(typ, key))
+	}
+
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	switch typ {
+	case "string":
+		val, ok := c.strCache[key]
+		return val, ok
+	case "strings":
+		val, ok := c.sArrCache[key]
+		return val, ok
+	case "strmap":
+		val, ok := c.sMapCache[key]
+		return val, ok
+	default:
+		return nil, false
+	}
+}
+
+// cacheSet writes through the installed Cache if any, else through the
+// legacy per-type maps.
+func (c *Config) cacheSet(typ, key string, val interface{}) {
+	if !c.opts.EnableCache {
+		return
+	}
+
+	if c.opts.Cache != nil {
+		c.opts.Cache.Set(cacheKey(typ, key), val, 0)
+		return
+	}
+
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	switch typ {
+	case "string":
+		if c.strCache == nil {
+			c.strCache = make(map[string]string)
+		}
+		c.strCache[key] = val.(string)
+	case "strings":
+		if c.sArrCache == nil {
+			c.sArrCache = make(map[string]strArr)
+		}
+		c.sArrCache[key] = val.(strArr)
+	case "strmap":
+		if c.sMapCache == nil {
+			c.sMapCache = make(map[string]strMap)
+		}
+		c.sMapCache[key] = val.(strMap)
+	}
+}
+
+// invalidateCaches clears every getter cache - the installed Cache (if
+// any) and the legacy per-type maps. It's called on reload (Set, Watch)
+// since a changed value could affect any previously cached key.
+func (c *Config) invalidateCaches() {
+	c.cacheMu.Lock()
+	c.strCache = nil
+	c.sArrCache = nil
+	c.sMapCache = nil
+	c.cacheMu.Unlock()
+
+	if c.opts.Cache != nil {
+		c.opts.Cache.Purge()
+	}
+}
+
+/*************************************************************
+ * built-in Cache: size-capped LRU
+ *************************************************************/
+
+type lruEntry struct {
+	key string
+	val interface{}
+}
+
+// lruCache is a fixed-size, least-recently-used Cache. Get/Set/Delete are
+// all O(1).
+type lruCache struct {
+	mu       sync.Mutex
+	size     int
+	ll       *list.List
+	elements map[string]*list.Element
+}
+
+// NewLRUCache creates a Cache that evicts the least-recently-used entry
+// once it holds more than size items.
+func NewLRUCache(size int) Cache {
+	if size <= 0 {
+		size = 1
+	}
+
+	return &lruCache{
+		size:     size,
+		ll:       list.New(),
+		elements: make(map[string]*list.Element),
+	}
+}
+
+func (l *lruCache) Get(key string) (interface{}, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	el, ok := l.elements[key]
+	if !ok {
+		return nil, false
+	}
+
+	l.ll.MoveToFront(el)
+	return el.Value.(*lruEntry).val, true
+}
+
+func (l *lruCache) Set(key string, val interface{}, _ time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if el, ok := l.elements[key]; ok {
+		el.Value.(*lruEntry).val = val
+		l.ll.MoveToFront(el)
+		return
+	}
+
+	el := l.ll.PushFront(&lruEntry{key: key, val: val})
+	l.elements[key] = el
+
+	if l.ll.Len() > l.size {
+		oldest := l.ll.Back()
+		if oldest != nil {
+			l.ll.Remove(oldest)
+			delete(l.elements, oldest.Value.(*lruEntry).key)
+		}
+	}
+}
+
+func (l *lruCache) Delete(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if el, ok := l.elements[key]; ok {
+		l.ll.Remove(el)
+		delete(l.elements, key)
+	}
+}
+
+func (l *lruCache) Purge() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.ll.Init()
+	l.elements = make(map[string]*list.Element)
+}
+
+/*************************************************************
+ * built-in Cache: TTL
+ *************************************************************/
+
+type ttlEntry struct {
+	val      interface{}
+	expireAt time.Time // zero means "never expires"
+}
+
+// ttlCache is a Cache where every entry expires defaultTTL after it was
+// set, unless Set is called with an explicit non-zero ttl. Expired
+// entries are evicted lazily, on the next Get/Set that touches them.
+type ttlCache struct {
+	mu         sync.Mutex
+	defaultTTL time.Duration
+	entries    map[string]ttlEntry
+}
+
+// NewTTLCache creates a Cache whose entries expire after defaultTTL. A
+// defaultTTL of 0 means entries never expire unless Set is called with an
+// explicit ttl.
+func NewTTLCache(defaultTTL time.Duration) Cache {
+	return &ttlCache{
+		defaultTTL: defaultTTL,
+		entries:    make(map[string]ttlEntry),
+	}
+}
+
+func (t *ttlCache) Get(key string) (interface{}, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	e, ok := t.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if !e.expireAt.IsZero() && time.Now().After(e.expireAt) {
+		delete(t.entries, key)
+		return nil, false
+	}
+
+	return e.val, true
+}
+
+func (t *ttlCache) Set(key string, val interface{}, ttl time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if ttl == 0 {
+		ttl = t.defaultTTL
+	}
+
+	e := ttlEntry{val: val}
+	if ttl > 0 {
+		e.expireAt = time.Now().Add(ttl)
+	}
+
+	t.entries[key] = e
+}
+
+func (t *ttlCache) Delete(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.entries, key)
+}
+
+func (t *ttlCache) Purge() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.entries = make(map[string]ttlEntry)
+}
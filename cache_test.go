@@ -0,0 +1,96 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRUCache_evictsOldest(t *testing.T) {
+	c := NewLRUCache(2)
+
+	c.Set("a", 1, 0)
+	c.Set("b", 2, 0)
+	c.Set("c", 3, 0) // evicts "a", the least-recently-used entry
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("want 'a' evicted")
+	}
+	if v, ok := c.Get("b"); !ok || v != 2 {
+		t.Fatalf("want b=2, got %v ok=%v", v, ok)
+	}
+	if v, ok := c.Get("c"); !ok || v != 3 {
+		t.Fatalf("want c=3, got %v ok=%v", v, ok)
+	}
+}
+
+func TestTTLCache_expires(t *testing.T) {
+	c := NewTTLCache(10 * time.Millisecond)
+
+	c.Set("a", 1, 0)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatalf("want a=1 before expiry, got %v ok=%v", v, ok)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("want 'a' expired")
+	}
+}
+
+func TestConfig_WithCache(t *testing.T) {
+	c := New("test", WithCache(NewLRUCache(10)))
+	c.opts.EnableCache = true
+
+	c.data = map[string]interface{}{"name": "app"}
+
+	val, ok := c.String("name")
+	if !ok || val != "app" {
+		t.Fatalf("want name=app, got %v ok=%v", val, ok)
+	}
+
+	if _, ok := c.opts.Cache.Get(cacheKey("string", "name")); !ok {
+		t.Fatalf("want value to be cached in the installed Cache")
+	}
+}
+
+func TestConfig_Set_invalidatesCache(t *testing.T) {
+	c := New("test")
+	c.opts.EnableCache = true
+	c.data = map[string]interface{}{"name": "app"}
+
+	if val, ok := c.String("name"); !ok || val != "app" {
+		t.Fatalf("want name=app, got %v ok=%v", val, ok)
+	}
+
+	if err := c.Set("name", "changed"); err != nil {
+		t.Fatalf("Set error: %v", err)
+	}
+
+	if val, ok := c.String("name"); !ok || val != "changed" {
+		t.Fatalf("want name=changed after Set, got %v ok=%v (stale cache not invalidated)", val, ok)
+	}
+}
+
+// TestCache_concurrentAccess exercises cacheGet/cacheSet/invalidateCaches
+// from multiple goroutines at once - the shape Watch's file reload and
+// AddRemoteProvider's background goroutines produce in production - under
+// the race detector.
+func TestCache_concurrentAccess(t *testing.T) {
+	c := New("test")
+	c.opts.EnableCache = true
+	c.data = map[string]interface{}{"name": "app"}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 200; i++ {
+			_, _ = c.String("name")
+		}
+	}()
+
+	for i := 0; i < 200; i++ {
+		c.invalidateCaches()
+	}
+	<-done
+}
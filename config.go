@@ -0,0 +1,366 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// Version is package version
+const Version = "1.1.0"
+
+// There are supported config format
+const (
+	Ini  = "ini"
+	Hcl  = "hcl"
+	Yml  = "yml"
+	JSON = "json"
+	Yaml = "yaml"
+	Toml = "toml"
+)
+
+// internal vars
+type intArr []int
+type strArr []string
+type intMap map[string]int
+type strMap map[string]string
+
+// Driver interface
+type Driver interface {
+	Name() string
+	GetDecoder() Decoder
+	GetEncoder() Encoder
+}
+
+// Decoder for decode yml,json,toml format content
+type Decoder func(blob []byte, v interface{}) (err error)
+
+// Encoder for encode yml,json,toml format content
+type Encoder func(v interface{}) (out []byte, err error)
+
+// Options config options
+type Options struct {
+	// parse env value. like: "${EnvName}" "${EnvName|default}"
+	ParseEnv bool
+	// config is readonly
+	Readonly bool
+	// enable config data cache
+	EnableCache bool
+	// default write format
+	DumpFormat string
+	// default input format
+	ReadFormat string
+	// Cache is the pluggable cache backend the typed getters (String,
+	// Strings, StringMap, ...) read/write through. Nil falls back to the
+	// legacy per-type maps (strCache/sArrCache/sMapCache).
+	Cache Cache
+}
+
+// Config structure definition
+type Config struct {
+	err error
+	// config instance name
+	name string
+	lock sync.RWMutex
+
+	// config options
+	opts *Options
+	// all config data
+	data map[string]interface{}
+
+	// loaded config files records
+	loadedFiles []string
+
+	// decoders["toml"] = func(blob []byte, v interface{}) (err error){}
+	// decoders["yaml"] = func(blob []byte, v interface{}) (err error){}
+	decoders map[string]Decoder
+	encoders map[string]Encoder
+
+	// cache got config data
+	intCache map[string]int
+	strCache map[string]string
+
+	iArrCache map[string]intArr
+	iMapCache map[string]intMap
+	sArrCache map[string]strArr
+	sMapCache map[string]strMap
+
+	// cacheMu guards strCache/sArrCache/sMapCache. It's a mutex of its own
+	// rather than c.lock because invalidateCaches runs both standalone
+	// (from ClearCaches) and nested inside an existing c.lock critical
+	// section (Set, reloadFile, mergeRemoteBlob), and c.lock isn't reentrant.
+	cacheMu sync.Mutex
+
+	// watcher holds the running Watch() state, nil until Watch is called.
+	watcher *watcher
+	// pendingOnChange stashes OnChange handlers registered before the
+	// first Watch() call, keyed by dot-path, so they aren't lost.
+	pendingOnChange map[string][]ChangeHandler
+
+	// remotes holds every provider registered via AddRemoteProvider, keyed
+	// by name, so RemoveRemoteProvider can look it up and cancel it.
+	remotes map[string]*remoteProvider
+}
+
+// New config instance. opts are applied to the default options the same
+// way NewWithOptions does, so callers can write New("app", WithCache(...))
+// directly instead of reaching for NewWithOptions.
+func New(name string, opts ...func(*Options)) *Config {
+	c := &Config{
+		name: name,
+		data: make(map[string]interface{}),
+
+		// init options
+		opts: &Options{DumpFormat: JSON, ReadFormat: JSON},
+
+		// default add JSON driver
+		encoders: map[string]Encoder{JSON: JSONEncoder},
+		decoders: map[string]Decoder{JSON: JSONDecoder},
+	}
+
+	c.WithOptions(opts...)
+	return c
+}
+
+// NewEmpty config instance
+func NewEmpty(name string) *Config {
+	return &Config{
+		name: name,
+		data: make(map[string]interface{}),
+
+		// empty options
+		opts: &Options{},
+
+		// don't add any drivers
+		encoders: map[string]Encoder{},
+		decoders: map[string]Decoder{},
+	}
+}
+
+// NewWithOptions config instance
+func NewWithOptions(name string, opts ...func(*Options)) *Config {
+	return New(name, opts...)
+}
+
+/*************************************************************
+ * config setting
+ *************************************************************/
+
+// ParseEnv set parse env
+func ParseEnv(opts *Options) {
+	opts.ParseEnv = true
+}
+
+// Readonly set readonly
+func Readonly(opts *Options) {
+	opts.Readonly = true
+}
+
+// EnableCache enable config data cache
+func EnableCache(opts *Options) {
+	opts.EnableCache = true
+}
+
+// WithOptions apply some options
+func (c *Config) WithOptions(opts ...func(*Options)) {
+	if !c.IsEmpty() {
+		panic("config: Cannot set options after data has been loaded")
+	}
+
+	// apply options
+	for _, opt := range opts {
+		opt(c.opts)
+	}
+}
+
+// Options get
+func (c *Config) Options() *Options {
+	return c.opts
+}
+
+// Readonly disable set data to config.
+// Usage:
+//	config.LoadFiles(a, b, c)
+//	config.Readonly()
+func (c *Config) Readonly() {
+	c.opts.Readonly = true
+}
+
+/*************************************************************
+ * config drivers
+ *************************************************************/
+
+// AddDriver set a decoder and encoder driver for a format.
+func (c *Config) AddDriver(driver Driver) {
+	format := driver.Name()
+	c.decoders[format] = driver.GetDecoder()
+	c.encoders[format] = driver.GetEncoder()
+}
+
+// HasDecoder has decoder
+func (c *Config) HasDecoder(format string) bool {
+	format = fixFormat(format)
+	_, ok := c.decoders[format]
+	return ok
+}
+
+// SetDecoder set decoder
+func (c *Config) SetDecoder(format string, decoder Decoder) {
+	format = fixFormat(format)
+	c.decoders[format] = decoder
+}
+
+// SetDecoders set decoders
+func (c *Config) SetDecoders(decoders map[string]Decoder) {
+	for format, decoder := range decoders {
+		c.SetDecoder(format, decoder)
+	}
+}
+
+// SetEncoder set a encoder for the format
+func (c *Config) SetEncoder(format string, encoder Encoder) {
+	format = fixFormat(format)
+	c.encoders[format] = encoder
+}
+
+// SetEncoders set encoders
+func (c *Config) SetEncoders(encoders map[string]Encoder) {
+	for format, encoder := range encoders {
+		c.SetEncoder(format, encoder)
+	}
+}
+
+// HasEncoder has encoder
+func (c *Config) HasEncoder(format string) bool {
+	format = fixFormat(format)
+	_, ok := c.encoders[format]
+	return ok
+}
+
+// DelDriver delete driver of the format
+func (c *Config) DelDriver(format string) {
+	format = fixFormat(format)
+
+	if _, ok := c.decoders[format]; ok {
+		delete(c.decoders, format)
+	}
+
+	if _, ok := c.encoders[format]; ok {
+		delete(c.encoders, format)
+	}
+}
+
+/*************************************************************
+ * helper methods
+ *************************************************************/
+
+// Name get config name
+func (c *Config) Name() string {
+	return c.name
+}
+
+// Data get all config data
+func (c *Config) Data() map[string]interface{} {
+	return c.data
+}
+
+// Error get last error
+func (c *Config) Error() error {
+	return c.err
+}
+
+// ToJSON string
+func (c *Config) ToJSON() string {
+	buf := &bytes.Buffer{}
+
+	_, err := c.DumpTo(buf, JSON)
+	if err != nil {
+		return ""
+	}
+
+	return buf.String()
+}
+
+// WriteTo Write out config data representing the current state to a writer.
+func (c *Config) WriteTo(out io.Writer) (n int64, err error) {
+	return c.DumpTo(out, c.opts.DumpFormat)
+}
+
+// DumpTo use the format(json,yaml,toml) dump config data to a writer
+func (c *Config) DumpTo(out io.Writer, format string) (n int64, err error) {
+	var ok bool
+	var encoder Encoder
+
+	format = fixFormat(format)
+	if encoder, ok = c.encoders[format]; !ok {
+		err = errors.New("no exists or no register encoder for the format: " + format)
+		return
+	}
+
+	// is empty
+	if len(c.data) == 0 {
+		return
+	}
+
+	// encode data to string
+	encoded, err := encoder(&c.data)
+	if err != nil {
+		return
+	}
+
+	// write content to out
+	num, err := fmt.Fprintln(out, string(encoded))
+	if err != nil {
+		return
+	}
+
+	return int64(num), nil
+}
+
+// LoadedFiles get loaded files name
+func (c *Config) LoadedFiles() []string {
+	return c.loadedFiles
+}
+
+// ClearAll data and caches
+func (c *Config) ClearAll() {
+	c.ClearData()
+	c.ClearCaches()
+
+	c.loadedFiles = []string{}
+	c.opts.Readonly = false
+}
+
+// ClearData clear data
+func (c *Config) ClearData() {
+	c.data = make(map[string]interface{})
+	c.loadedFiles = []string{}
+}
+
+// ClearCaches clear caches
+func (c *Config) ClearCaches() {
+	if c.opts.EnableCache {
+		c.invalidateCaches()
+	}
+}
+
+// record error
+func (c *Config) addError(err error) {
+	c.err = err
+}
+
+// format and record error
+func (c *Config) addErrorf(format string, a ...interface{}) {
+	c.err = fmt.Errorf(format, a...)
+}
+
+// fix yaml format
+func fixFormat(f string) string {
+	if f == Yml {
+		f = Yaml
+	}
+
+	return f
+}
@@ -123,11 +123,8 @@ func (c *Config) Get(key string, findByPath ...bool) (value interface{}, ok bool
 // String get a string by key
 func (c *Config) String(key string) (value string, ok bool) {
 	// find from cache
-	if c.opts.EnableCache && len(c.strCache) > 0 {
-		value, ok = c.strCache[key]
-		if ok {
-			return
-		}
+	if cached, found := c.cacheGet("string", key); found {
+		return cached.(string), true
 	}
 
 	val, ok := c.Get(key)
@@ -152,12 +149,8 @@ func (c *Config) String(key string) (value string, ok bool) {
 	}
 
 	// add cache
-	if ok && c.opts.EnableCache {
-		if c.strCache == nil {
-			c.strCache = make(map[string]string)
-		}
-
-		c.strCache[key] = value
+	if ok {
+		c.cacheSet("string", key, value)
 	}
 	return
 }
@@ -388,11 +381,8 @@ func (c *Config) IntMap(key string) (mp map[string]int, ok bool) {
 // Strings get config data as a string slice/array
 func (c *Config) Strings(key string) (arr []string, ok bool) {
 	// find from cache
-	if c.opts.EnableCache && len(c.sArrCache) > 0 {
-		arr, ok = c.sArrCache[key]
-		if ok {
-			return
-		}
+	if cached, found := c.cacheGet("strings", key); found {
+		return cached.(strArr), true
 	}
 
 	rawVal, ok := c.Get(key)
@@ -413,12 +403,8 @@ func (c *Config) Strings(key string) (arr []string, ok bool) {
 	}
 
 	// add cache
-	if ok && c.opts.EnableCache {
-		if c.sArrCache == nil {
-			c.sArrCache = make(map[string]strArr)
-		}
-
-		c.sArrCache[key] = arr
+	if ok {
+		c.cacheSet("strings", key, strArr(arr))
 	}
 	return
 }
@@ -426,11 +412,8 @@ func (c *Config) Strings(key string) (arr []string, ok bool) {
 // StringMap get config data as a map[string]string
 func (c *Config) StringMap(key string) (mp map[string]string, ok bool) {
 	// find from cache
-	if c.opts.EnableCache && len(c.sMapCache) > 0 {
-		mp, ok = c.sMapCache[key]
-		if ok {
-			return
-		}
+	if cached, found := c.cacheGet("strmap", key); found {
+		return cached.(strMap), true
 	}
 
 	rawVal, ok := c.Get(key)
@@ -458,12 +441,8 @@ func (c *Config) StringMap(key string) (mp map[string]string, ok bool) {
 	}
 
 	// add cache
-	if ok && c.opts.EnableCache {
-		if c.sMapCache == nil {
-			c.sMapCache = make(map[string]strMap)
-		}
-
-		c.sMapCache[key] = mp
+	if ok {
+		c.cacheSet("strmap", key, strMap(mp))
 	}
 	return
 }
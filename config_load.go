@@ -0,0 +1,251 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/imdario/mergo"
+)
+
+// LoadFiles load and parse config files
+func (c *Config) LoadFiles(sourceFiles ...string) (err error) {
+	for _, file := range sourceFiles {
+		if err = c.loadFile(file, false); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// LoadExists load and parse config files, but will ignore not exists file.
+func (c *Config) LoadExists(sourceFiles ...string) (err error) {
+	for _, file := range sourceFiles {
+		if err = c.loadFile(file, true); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// load config file
+func (c *Config) loadFile(file string, loadExist bool) (err error) {
+	// open file
+	fd, err := os.Open(file)
+	if err != nil {
+		// skip not exist file
+		if os.IsNotExist(err) && loadExist {
+			return nil
+		}
+		return err
+	}
+	defer fd.Close()
+
+	// read file content
+	bts, err := ioutil.ReadAll(fd)
+	if err == nil {
+		// get format for file ext
+		format := strings.Trim(filepath.Ext(file), ".")
+
+		// parse file content
+		if err = c.parseSourceCode(format, bts); err != nil {
+			return
+		}
+
+		c.loadedFiles = append(c.loadedFiles, file)
+	}
+
+	return
+}
+
+// LoadRemote load config data from remote URL.
+// Usage:
+// 	c.LoadRemote(config.JSON, "http://abc.com/api-config.json")
+func (c *Config) LoadRemote(format, url string) (err error) {
+	// create http client
+	client := http.Client{Timeout: 900 * time.Second}
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("remote resource is not exist, reply status code is not equals to 200")
+	}
+
+	// read response content
+	bts, err := ioutil.ReadAll(resp.Body)
+	if err == nil {
+		// parse file content
+		if err = c.parseSourceCode(format, bts); err != nil {
+			return
+		}
+
+		c.loadedFiles = append(c.loadedFiles, url)
+	}
+	return
+}
+
+// LoadFlags parse command line arguments, based on provide keys.
+// Usage:
+// 	c.LoadFlags([]string{"env", "debug"})
+func (c *Config) LoadFlags(keys []string) (err error) {
+	hash := map[string]*string{}
+	for _, key := range keys {
+		key = strings.Trim(key, "-")
+		hash[key] = new(string)
+		defVal, _ := c.String(key)
+		flag.StringVar(hash[key], key, defVal, "")
+	}
+
+	flag.Parse()
+	flag.Visit(func(f *flag.Flag) {
+		name := f.Name
+		// only get name in the keys.
+		if _, ok := hash[name]; !ok {
+			return
+		}
+
+		// ignore error
+		_ = c.Set(name, f.Value.String())
+	})
+
+	return
+}
+
+// LoadData load data from map OR struct
+func (c *Config) LoadData(dataSources ...interface{}) (err error) {
+	for _, ds := range dataSources {
+		err = mergo.Merge(&c.data, ds, mergo.WithOverride)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// LoadSources load data from byte content.
+// Usage:
+// 	config.LoadSources(config.Yml, []byte(`
+// 	name: blog
+// 	arr:
+// 		key: val
+// `))
+func (c *Config) LoadSources(format string, src []byte, more ...[]byte) (err error) {
+	err = c.parseSourceCode(format, src)
+	if err != nil {
+		return
+	}
+
+	for _, sc := range more {
+		err = c.parseSourceCode(format, sc)
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// LoadStrings load data from source string content.
+func (c *Config) LoadStrings(format string, str string, more ...string) (err error) {
+	err = c.parseSourceCode(format, []byte(str))
+	if err != nil {
+		return
+	}
+
+	for _, s := range more {
+		err = c.parseSourceCode(format, []byte(s))
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// decodeSourceCode decodes blob with the driver registered for format and
+// returns the parsed data, without touching c.data. It's the lookup half
+// of parseSourceCode, factored out so callers that need to merge the
+// result themselves under their own lock (Watch's file reload,
+// AddRemoteProvider) can reuse it instead of duplicating the decoder
+// dispatch.
+func (c *Config) decodeSourceCode(format string, blob []byte) (data map[string]interface{}, err error) {
+	// look the decoder up directly in c.decoders instead of a fixed switch
+	// over the built-in format constants, so formats registered later via
+	// AddDriver (HCL, properties, ...) dispatch the same way the built-in
+	// ones do.
+	decoder, ok := c.decoders[fixFormat(format)]
+	if !ok {
+		return nil, errors.New("no exists or no register decoder for the format: " + format)
+	}
+
+	data = make(map[string]interface{})
+	if err = decoder(blob, &data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// parse config source code to Config.
+func (c *Config) parseSourceCode(format string, blob []byte) (err error) {
+	data, err := c.decodeSourceCode(format, blob)
+	if err != nil {
+		return err
+	}
+
+	// init config data
+	if len(c.data) == 0 {
+		c.data = data
+	} else {
+		// again ... will merge data
+		err = mergo.Merge(&c.data, data, mergo.WithOverride)
+	}
+
+	return
+}
+
+// mergeStringMap merges src over a copy of dst (nested map[string]interface{}
+// values included) and returns the result, leaving dst itself untouched so
+// callers can atomically swap it into c.data under the write lock. override
+// mirrors mergo.WithOverride: when true, keys present in both maps take
+// src's value.
+func mergeStringMap(dst, src map[string]interface{}, override bool) map[string]interface{} {
+	// mergo merges nested maps in place, so they're copied too - otherwise
+	// the merge would rewrite dst's nested maps through the copy.
+	var copyMap func(m map[string]interface{}) map[string]interface{}
+	copyMap = func(m map[string]interface{}) map[string]interface{} {
+		out := make(map[string]interface{}, len(m))
+		for k, v := range m {
+			if sub, ok := v.(map[string]interface{}); ok {
+				v = copyMap(sub)
+			}
+			out[k] = v
+		}
+		return out
+	}
+	merged := copyMap(dst)
+
+	var opts []func(*mergo.Config)
+	if override {
+		opts = append(opts, mergo.WithOverride)
+	}
+
+	if err := mergo.Merge(&merged, src, opts...); err != nil {
+		// mergo only errors on irreconcilable type conflicts; fall back to
+		// a plain top-level override rather than dropping the update.
+		for k, v := range src {
+			merged[k] = v
+		}
+	}
+
+	return merged
+}
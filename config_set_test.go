@@ -0,0 +1,21 @@
+package config
+
+import "testing"
+
+func TestConfig_Set_arrayIndexOutOfRange(t *testing.T) {
+	c := New("test")
+	c.data["arr"] = []interface{}{"a", "b", "c"}
+
+	if err := c.Set("arr.3", "d"); err == nil {
+		t.Fatalf("want error for out-of-range index, got nil")
+	}
+
+	if err := c.Set("arr.2", "d"); err != nil {
+		t.Fatalf("want last valid index to succeed, got: %v", err)
+	}
+
+	arr, ok := c.data["arr"].([]interface{})
+	if !ok || arr[2] != "d" {
+		t.Fatalf("want arr[2]=d, got %#v", c.data["arr"])
+	}
+}
@@ -0,0 +1,42 @@
+package config
+
+import (
+	"errors"
+
+	"github.com/hashicorp/hcl"
+)
+
+var errHCLEncodeNotSupported = errors.New("config: encoding to HCL is not supported")
+
+// HCLDriver handles HCL content parsing.
+var HCLDriver = &hclDriver{}
+
+// hclDriver implements the Driver interface for HCL(HashiCorp Configuration Language).
+type hclDriver struct{}
+
+// Name of the driver
+func (d *hclDriver) Name() string {
+	return "hcl"
+}
+
+// GetDecoder for the driver
+func (d *hclDriver) GetDecoder() Decoder {
+	return HCLDecoder
+}
+
+// GetEncoder for the driver. HCL encoding is not supported, so it returns
+// an encoder that always fails.
+func (d *hclDriver) GetEncoder() Encoder {
+	return HCLEncoder
+}
+
+// HCLDecoder the hcl content Decoder
+var HCLDecoder Decoder = func(blob []byte, v interface{}) (err error) {
+	return hcl.Unmarshal(blob, v)
+}
+
+// HCLEncoder the hcl content Encoder. hashicorp/hcl only exposes a parser,
+// not a writer, so encoding back to HCL isn't supported.
+var HCLEncoder Encoder = func(v interface{}) (out []byte, err error) {
+	return nil, errHCLEncodeNotSupported
+}
@@ -0,0 +1,292 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PropertiesDriver handles Java-style ".properties" content parsing.
+var PropertiesDriver = &propertiesDriver{}
+
+// propertiesDriver implements the Driver interface for ".properties" files.
+type propertiesDriver struct{}
+
+// Name of the driver
+func (d *propertiesDriver) Name() string {
+	return "properties"
+}
+
+// GetDecoder for the driver
+func (d *propertiesDriver) GetDecoder() Decoder {
+	return PropertiesDecoder
+}
+
+// GetEncoder for the driver
+func (d *propertiesDriver) GetEncoder() Encoder {
+	return PropertiesEncoder
+}
+
+// PropertiesDecoder the properties content Decoder.
+//
+// It parses "key=value" lines (supporting "#"/"!" comments, "\" line
+// continuation and "\uXXXX" escapes), then explodes dotted keys
+// (eg. "server.http.port=8080") into a nested map[string]interface{} so
+// the rest of the package (Get's dot-path lookup, Structure, ...) works
+// exactly like it does for JSON/YAML/TOML.
+var PropertiesDecoder Decoder = func(blob []byte, v interface{}) (err error) {
+	flat, err := parsePropertiesText(blob)
+	if err != nil {
+		return err
+	}
+
+	nested := explodePropertiesKeys(flat)
+
+	// re-use the JSON codec to get the parsed data into v, same trick
+	// Structure() uses to map data onto an arbitrary destination.
+	out, err := JSONEncoder(nested)
+	if err != nil {
+		return err
+	}
+	return JSONDecoder(out, v)
+}
+
+// PropertiesEncoder the properties content Encoder. It flattens a nested
+// map/struct back into "key=value" lines, exploding arrays as
+// "key.0=a", "key.1=b" - the inverse of PropertiesDecoder.
+var PropertiesEncoder Encoder = func(v interface{}) (out []byte, err error) {
+	blob, err := JSONEncoder(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string]interface{}
+	if err = JSONDecoder(blob, &data); err != nil {
+		return nil, err
+	}
+
+	flat := make(map[string]string)
+	flattenToProperties("", data, flat)
+
+	keys := make([]string, 0, len(flat))
+	for k := range flat {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	buf := &bytes.Buffer{}
+	for _, k := range keys {
+		buf.WriteString(k)
+		buf.WriteByte('=')
+		buf.WriteString(flat[k])
+		buf.WriteByte('\n')
+	}
+
+	return buf.Bytes(), nil
+}
+
+// parsePropertiesText parses the raw ".properties" content into a flat
+// key -> value map, honouring comments, line continuation and unicode
+// escapes.
+func parsePropertiesText(blob []byte) (map[string]string, error) {
+	out := make(map[string]string)
+
+	scanner := bufio.NewScanner(bytes.NewReader(blob))
+	var pending string
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if pending != "" {
+			line = pending + strings.TrimLeft(line, " \t")
+			pending = ""
+		} else {
+			trimmed := strings.TrimSpace(line)
+			if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "!") {
+				continue
+			}
+			line = trimmed
+		}
+
+		// trailing "\" (not "\\") means the value continues on the next line.
+		if strings.HasSuffix(line, "\\") && !strings.HasSuffix(line, "\\\\") {
+			pending = strings.TrimSuffix(line, "\\")
+			continue
+		}
+
+		key, val, err := splitPropertiesLine(line)
+		if err != nil {
+			return nil, err
+		}
+
+		out[key] = val
+	}
+
+	if pending != "" {
+		key, val, err := splitPropertiesLine(pending)
+		if err != nil {
+			return nil, err
+		}
+		out[key] = val
+	}
+
+	return out, scanner.Err()
+}
+
+// splitPropertiesLine splits a single logical "key=value" (or "key:value")
+// line and unescapes the value.
+func splitPropertiesLine(line string) (key, val string, err error) {
+	sep := strings.IndexAny(line, "=:")
+	if sep == -1 {
+		return strings.TrimSpace(line), "", nil
+	}
+
+	key = strings.TrimSpace(line[:sep])
+	val, err = unescapePropertiesValue(strings.TrimSpace(line[sep+1:]))
+	return
+}
+
+// unescapePropertiesValue resolves "\uXXXX" unicode escapes and the common
+// "\t", "\n", "\r" escapes used in Java ".properties" values.
+func unescapePropertiesValue(val string) (string, error) {
+	if !strings.Contains(val, "\\") {
+		return val, nil
+	}
+
+	var buf strings.Builder
+	runes := []rune(val)
+
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '\\' || i == len(runes)-1 {
+			buf.WriteRune(runes[i])
+			continue
+		}
+
+		i++
+		switch runes[i] {
+		case 'u':
+			if i+4 >= len(runes) {
+				return "", fmt.Errorf("config: invalid \\u escape in properties value %q", val)
+			}
+
+			code, err := strconv.ParseInt(string(runes[i+1:i+5]), 16, 32)
+			if err != nil {
+				return "", fmt.Errorf("config: invalid \\u escape in properties value %q: %w", val, err)
+			}
+
+			buf.WriteRune(rune(code))
+			i += 4
+		case 't':
+			buf.WriteByte('\t')
+		case 'n':
+			buf.WriteByte('\n')
+		case 'r':
+			buf.WriteByte('\r')
+		case '\\':
+			buf.WriteByte('\\')
+		default:
+			buf.WriteRune(runes[i])
+		}
+	}
+
+	return buf.String(), nil
+}
+
+// explodePropertiesKeys turns a flat "a.b.c" -> value map into the nested
+// map[string]interface{} the rest of the package expects, detecting
+// consecutive "0", "1", ... numeric segments and building a []interface{}
+// for them instead of a map.
+func explodePropertiesKeys(flat map[string]string) map[string]interface{} {
+	root := make(map[string]interface{})
+
+	for key, val := range flat {
+		segs := strings.Split(key, ".")
+		setNestedValue(root, segs, val)
+	}
+
+	return arrayifyNestedMaps(root).(map[string]interface{})
+}
+
+func setNestedValue(node map[string]interface{}, segs []string, val string) {
+	seg := segs[0]
+
+	if len(segs) == 1 {
+		node[seg] = val
+		return
+	}
+
+	child, ok := node[seg].(map[string]interface{})
+	if !ok {
+		child = make(map[string]interface{})
+		node[seg] = child
+	}
+
+	setNestedValue(child, segs[1:], val)
+}
+
+// arrayifyNestedMaps walks data depth-first and converts any
+// map[string]interface{} whose keys are exactly "0".."len-1" into a
+// []interface{}, so "foo.0=a foo.1=b" decodes the same way a JSON/YAML
+// array would.
+func arrayifyNestedMaps(data interface{}) interface{} {
+	m, ok := data.(map[string]interface{})
+	if !ok {
+		return data
+	}
+
+	for k, v := range m {
+		m[k] = arrayifyNestedMaps(v)
+	}
+
+	if !isZeroBasedIndexMap(m) {
+		return m
+	}
+
+	arr := make([]interface{}, len(m))
+	for k, v := range m {
+		i, _ := strconv.Atoi(k)
+		arr[i] = v
+	}
+	return arr
+}
+
+func isZeroBasedIndexMap(m map[string]interface{}) bool {
+	if len(m) == 0 {
+		return false
+	}
+
+	for k := range m {
+		i, err := strconv.Atoi(k)
+		if err != nil || i < 0 || i >= len(m) {
+			return false
+		}
+	}
+	return true
+}
+
+// flattenToProperties is the inverse of explodePropertiesKeys: it walks a
+// decoded map/slice tree and writes "a.b.c" -> value entries into out.
+func flattenToProperties(prefix string, data interface{}, out map[string]string) {
+	switch typeData := data.(type) {
+	case map[string]interface{}:
+		for k, v := range typeData {
+			flattenToProperties(joinPropertiesKey(prefix, k), v, out)
+		}
+	case []interface{}:
+		for i, v := range typeData {
+			flattenToProperties(joinPropertiesKey(prefix, strconv.Itoa(i)), v, out)
+		}
+	default:
+		out[prefix] = fmt.Sprintf("%v", typeData)
+	}
+}
+
+func joinPropertiesKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
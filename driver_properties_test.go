@@ -0,0 +1,121 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPropertiesDecoder_nestedAndArrays(t *testing.T) {
+	src := []byte(`
+# a comment
+! another comment
+name=app
+server.http.port=8080
+server.http.hosts.0=a
+server.http.hosts.1=b
+server.tags.0.name=one
+server.tags.1.name=two
+long.value=hello \
+world
+`)
+
+	var data map[string]interface{}
+	if err := PropertiesDecoder(src, &data); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+
+	if data["name"] != "app" {
+		t.Fatalf("want name=app, got %v", data["name"])
+	}
+
+	server, ok := data["server"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("want server to be a map, got %T", data["server"])
+	}
+
+	httpCfg, ok := server["http"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("want server.http to be a map, got %T", server["http"])
+	}
+	if httpCfg["port"] != "8080" {
+		t.Fatalf("want server.http.port=8080, got %v", httpCfg["port"])
+	}
+
+	hosts, ok := httpCfg["hosts"].([]interface{})
+	if !ok || len(hosts) != 2 || hosts[0] != "a" || hosts[1] != "b" {
+		t.Fatalf("want server.http.hosts=[a b], got %#v", httpCfg["hosts"])
+	}
+
+	tags, ok := server["tags"].([]interface{})
+	if !ok || len(tags) != 2 {
+		t.Fatalf("want server.tags to be a 2-item array, got %#v", server["tags"])
+	}
+
+	if data["long"].(map[string]interface{})["value"] != "hello world" {
+		t.Fatalf("want line continuation joined, got %#v", data["long"])
+	}
+}
+
+func TestPropertiesEncoder_roundTrip(t *testing.T) {
+	src := map[string]interface{}{
+		"name": "app",
+		"server": map[string]interface{}{
+			"http": map[string]interface{}{
+				"port":  8080,
+				"hosts": []interface{}{"a", "b"},
+			},
+		},
+	}
+
+	blob, err := PropertiesEncoder(src)
+	if err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+
+	var out map[string]interface{}
+	if err := PropertiesDecoder(blob, &out); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+
+	server := out["server"].(map[string]interface{})
+	httpCfg := server["http"].(map[string]interface{})
+
+	if httpCfg["port"] != "8080" {
+		t.Fatalf("want round-tripped port=8080, got %v", httpCfg["port"])
+	}
+
+	hosts := httpCfg["hosts"].([]interface{})
+	if len(hosts) != 2 || hosts[0] != "a" || hosts[1] != "b" {
+		t.Fatalf("want round-tripped hosts=[a b], got %#v", hosts)
+	}
+}
+
+// TestConfig_LoadFiles_properties exercises the driver through
+// LoadFiles/Get, not just PropertiesDecoder directly, so a regression in
+// the format dispatch used by LoadFiles/LoadStrings (parseSourceCode)
+// gets caught here too.
+func TestConfig_LoadFiles_properties(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "app.properties")
+
+	content := "name=app\nserver.http.port=8080\n"
+	if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+		t.Fatalf("write temp file: %v", err)
+	}
+
+	c := New("test")
+	c.AddDriver(PropertiesDriver)
+
+	if err := c.LoadFiles(file); err != nil {
+		t.Fatalf("LoadFiles error: %v", err)
+	}
+
+	if val, ok := c.Get("name"); !ok || val != "app" {
+		t.Fatalf("want name=app, got %v ok=%v", val, ok)
+	}
+
+	if val, ok := c.Get("server.http.port"); !ok || val != "8080" {
+		t.Fatalf("want server.http.port=8080, got %v ok=%v", val, ok)
+	}
+}
@@ -0,0 +1,270 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// EnvBindOption configures BindEnv.
+type EnvBindOption func(*envBindOpts)
+
+type envBindOpts struct {
+	prefix    string
+	snakeCase bool
+	dotFiles  []string
+}
+
+// WithEnvPrefix sets a prefix joined (with "_") in front of every derived
+// env name, eg. WithEnvPrefix("APP") turns "SERVER_PORT" into
+// "APP_SERVER_PORT". It has no effect on fields that set an explicit
+// `env` tag.
+func WithEnvPrefix(prefix string) EnvBindOption {
+	return func(o *envBindOpts) {
+		o.prefix = strings.Trim(prefix, "_")
+	}
+}
+
+// WithSnakeCase splits each field name's CamelCase words with "_" before
+// joining the path and upper-casing it, eg. a field named DBHost becomes
+// "DB_HOST" instead of the default "DBHOST". Off by default, since a
+// nested path like Server.HTTP.Port already derives SERVER_HTTP_PORT
+// without it.
+func WithSnakeCase(enable bool) EnvBindOption {
+	return func(o *envBindOpts) {
+		o.snakeCase = enable
+	}
+}
+
+// WithDotEnvFiles loads the given ".env" files (first file wins on key
+// conflicts) into the process environment before binding, without
+// overwriting variables already set in os.Environ().
+func WithDotEnvFiles(paths ...string) EnvBindOption {
+	return func(o *envBindOpts) {
+		o.dotFiles = append(o.dotFiles, paths...)
+	}
+}
+
+// BindEnv walks v reflectively and populates its fields from os.Getenv,
+// complementing the "${VAR|default}" string interpolation already
+// supported by String() with real, strongly-typed struct binding.
+//
+// Supported struct tags:
+//
+//	env:"DB_HOST"    use this exact env var name instead of the derived one
+//	default:"..."    value used when the env var is unset
+//	required:"true"  fail with an error if the env var is unset and no default
+//	separator:","    separator used to split slice fields, default ","
+//
+// When a field has no `env` tag, the name is derived by joining the parent
+// field path with "_" and upper-casing it, eg. Server.HTTP.Port becomes
+// SERVER_HTTP_PORT, optionally prefixed via WithEnvPrefix.
+//
+// v must be a non-nil pointer to a struct. BindEnv keeps walking on a
+// per-field failure and returns a single error combining every failed field.
+func (c *Config) BindEnv(v interface{}, opts ...EnvBindOption) error {
+	bindOpts := &envBindOpts{}
+	for _, fn := range opts {
+		fn(bindOpts)
+	}
+
+	for _, file := range bindOpts.dotFiles {
+		if err := loadDotEnvFile(file); err != nil {
+			return err
+		}
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("config: BindEnv requires a non-nil pointer to a struct, got %T", v)
+	}
+
+	var errs []string
+	bindStructEnv(rv.Elem(), bindOpts, nil, &errs)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("config: BindEnv failed:\n  %s", strings.Join(errs, "\n  "))
+	}
+	return nil
+}
+
+func bindStructEnv(rv reflect.Value, o *envBindOpts, path []string, errs *[]string) {
+	rt := rv.Type()
+
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if sf.PkgPath != "" { // unexported
+			continue
+		}
+
+		fv := rv.Field(i)
+		fieldPath := append(append([]string{}, path...), sf.Name)
+
+		if fv.Kind() == reflect.Struct && sf.Tag.Get("env") == "" {
+			bindStructEnv(fv, o, fieldPath, errs)
+			continue
+		}
+
+		envName := sf.Tag.Get("env")
+		if envName == "" {
+			envName = deriveEnvName(fieldPath, o)
+		}
+
+		raw, isSet := os.LookupEnv(envName)
+		if !isSet {
+			if def := sf.Tag.Get("default"); def != "" {
+				raw, isSet = def, true
+			}
+		}
+
+		if !isSet {
+			if sf.Tag.Get("required") == "true" {
+				*errs = append(*errs, fmt.Sprintf("%s: env var %q is required but not set", strings.Join(fieldPath, "."), envName))
+			}
+			continue
+		}
+
+		sep := sf.Tag.Get("separator")
+		if sep == "" {
+			sep = ","
+		}
+
+		if err := setFieldFromString(fv, raw, sep); err != nil {
+			*errs = append(*errs, fmt.Sprintf("%s (env %s): %v", strings.Join(fieldPath, "."), envName, err))
+		}
+	}
+}
+
+// deriveEnvName joins the field path with "_" and upper-cases it, applying
+// the configured prefix. With WithSnakeCase enabled, each segment's
+// CamelCase words are split with "_" first.
+func deriveEnvName(fieldPath []string, o *envBindOpts) string {
+	segs := fieldPath
+	if o.snakeCase {
+		segs = make([]string, len(fieldPath))
+		for i, seg := range fieldPath {
+			segs[i] = splitCamelCase(seg)
+		}
+	}
+
+	name := strings.ToUpper(strings.Join(segs, "_"))
+	if o.prefix == "" {
+		return name
+	}
+	return strings.ToUpper(o.prefix) + "_" + name
+}
+
+// splitCamelCase inserts "_" at CamelCase word boundaries, eg. "DBHost"
+// becomes "DB_Host", so deriveEnvName can upper-case it into "DB_HOST"
+// instead of "DBHOST".
+func splitCamelCase(s string) string {
+	runes := []rune(s)
+
+	var buf strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			var next rune
+			if i+1 < len(runes) {
+				next = runes[i+1]
+			}
+
+			if unicode.IsLower(prev) || (unicode.IsUpper(prev) && unicode.IsLower(next)) {
+				buf.WriteByte('_')
+			}
+		}
+		buf.WriteRune(r)
+	}
+
+	return buf.String()
+}
+
+func setFieldFromString(fv reflect.Value, raw, sep string) error {
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		fv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if fv.Type() == reflect.TypeOf(time.Duration(0)) {
+			d, err := time.ParseDuration(raw)
+			if err != nil {
+				return err
+			}
+			fv.SetInt(int64(d))
+			return nil
+		}
+
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		fv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		fv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(n)
+	case reflect.Slice:
+		parts := strings.Split(raw, sep)
+		slice := reflect.MakeSlice(fv.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if err := setFieldFromString(slice.Index(i), strings.TrimSpace(part), sep); err != nil {
+				return err
+			}
+		}
+		fv.Set(slice)
+	default:
+		return fmt.Errorf("unsupported field kind %s", fv.Kind())
+	}
+	return nil
+}
+
+// loadDotEnvFile parses a small ".env" file (KEY=VALUE per line, "#"
+// comments, optional surrounding quotes) and applies it to the process
+// environment without overwriting variables already set.
+func loadDotEnvFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		sep := strings.IndexByte(line, '=')
+		if sep == -1 {
+			continue
+		}
+
+		key := strings.TrimSpace(line[:sep])
+		val := strings.Trim(strings.TrimSpace(line[sep+1:]), `"'`)
+
+		if _, exists := os.LookupEnv(key); !exists {
+			_ = os.Setenv(key, val)
+		}
+	}
+
+	return scanner.Err()
+}
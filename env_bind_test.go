@@ -0,0 +1,95 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestConfig_BindEnv(t *testing.T) {
+	type HTTPConfig struct {
+		Port    int
+		Timeout time.Duration
+	}
+
+	type AppConfig struct {
+		Name  string `env:"APP_NAME" default:"demo"`
+		HTTP  HTTPConfig
+		Hosts []string `separator:"|"`
+	}
+
+	os.Setenv("SERVER_HTTP_PORT", "8080")
+	os.Setenv("SERVER_HTTP_TIMEOUT", "5s")
+	os.Setenv("SERVER_HOSTS", "a|b|c")
+	defer func() {
+		os.Unsetenv("SERVER_HTTP_PORT")
+		os.Unsetenv("SERVER_HTTP_TIMEOUT")
+		os.Unsetenv("SERVER_HOSTS")
+	}()
+
+	type Root struct {
+		Server AppConfig
+	}
+
+	c := New("test")
+
+	var cfg Root
+	if err := c.BindEnv(&cfg); err != nil {
+		t.Fatalf("BindEnv error: %v", err)
+	}
+
+	if cfg.Server.Name != "demo" {
+		t.Fatalf("want default name 'demo', got %q", cfg.Server.Name)
+	}
+	if cfg.Server.HTTP.Port != 8080 {
+		t.Fatalf("want port 8080, got %d", cfg.Server.HTTP.Port)
+	}
+	if cfg.Server.HTTP.Timeout != 5*time.Second {
+		t.Fatalf("want timeout 5s, got %v", cfg.Server.HTTP.Timeout)
+	}
+	if len(cfg.Server.Hosts) != 3 || cfg.Server.Hosts[1] != "b" {
+		t.Fatalf("want hosts [a b c], got %#v", cfg.Server.Hosts)
+	}
+}
+
+func TestConfig_BindEnv_required(t *testing.T) {
+	type Root struct {
+		APIKey string `env:"MISSING_REQUIRED_KEY" required:"true"`
+	}
+
+	c := New("test")
+
+	var cfg Root
+	if err := c.BindEnv(&cfg); err == nil {
+		t.Fatalf("want error for missing required env var, got nil")
+	}
+}
+
+func TestConfig_BindEnv_withSnakeCase(t *testing.T) {
+	type Root struct {
+		DBHost string
+	}
+
+	os.Setenv("DB_HOST", "db.internal")
+	defer os.Unsetenv("DB_HOST")
+
+	c := New("test")
+
+	var cfg Root
+	if err := c.BindEnv(&cfg, WithSnakeCase(true)); err != nil {
+		t.Fatalf("BindEnv error: %v", err)
+	}
+	if cfg.DBHost != "db.internal" {
+		t.Fatalf("want DBHost read from DB_HOST with WithSnakeCase(true), got %q", cfg.DBHost)
+	}
+
+	// without the option, the derived name stays DBHOST and the field is
+	// left unset since only DB_HOST is in the environment.
+	var cfg2 Root
+	if err := c.BindEnv(&cfg2); err != nil {
+		t.Fatalf("BindEnv error: %v", err)
+	}
+	if cfg2.DBHost != "" {
+		t.Fatalf("want DBHost unset without WithSnakeCase, got %q", cfg2.DBHost)
+	}
+}
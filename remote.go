@@ -0,0 +1,211 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DecryptFunc transparently decodes encrypted secrets (eg. sealed values
+// pulled from a KV store) before they're handed to the format decoder.
+type DecryptFunc func([]byte) ([]byte, error)
+
+// RemoteProvider is the source-side contract for a remote config backend.
+// Get performs a one-shot fetch; Watch streams subsequent updates for
+// backends that support it natively (etcd/Consul watches, long-poll, ...).
+// A provider that has no native watch support returns an error from
+// Watch; AddRemoteProvider then falls back to polling Get.
+type RemoteProvider interface {
+	// Get fetches the current value, along with a format hint ("json",
+	// "yaml", ...) used to pick the decoder.
+	Get(ctx context.Context) (blob []byte, format string, err error)
+	// Watch streams the raw content of each update as it happens, in the
+	// same format as Get's result (the caller decodes it). The channel is closed
+	// when ctx is done or the provider gives up on the subscription.
+	Watch(ctx context.Context) (<-chan []byte, error)
+}
+
+// remoteProvider is the book-keeping wrapper Config keeps per registered
+// remote source.
+type remoteProvider struct {
+	name    string
+	format  string
+	source  RemoteProvider
+	decrypt DecryptFunc
+	cancel  context.CancelFunc
+}
+
+// AddRemoteProvider fetches from provider, decrypts (if a decrypt hook
+// was configured via WithDecryptHook) and decodes the result with the
+// format driver registered under the format hint provider.Get returns
+// (or under format when that hint is empty), then merges it into the config
+// data exactly like LoadStrings would. If provider streams native
+// updates (or, failing that, is polled - see WithPollInterval) every
+// subsequent update is merged the same way and dispatched through the
+// same OnChange machinery Watch() uses for file hot-reload.
+func (c *Config) AddRemoteProvider(name string, provider RemoteProvider, format string, opts ...RemoteOption) error {
+	ro := &remoteOpts{pollInterval: defaultPollInterval}
+	for _, fn := range opts {
+		fn(ro)
+	}
+
+	rp := &remoteProvider{name: name, format: format, source: provider, decrypt: ro.decrypt}
+
+	blob, hint, err := provider.Get(context.Background())
+	if err != nil {
+		return fmt.Errorf("config: fetch remote provider %q: %w", name, err)
+	}
+	if hint != "" {
+		rp.format = hint
+	}
+
+	if err := c.mergeRemoteBlob(rp, blob); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	rp.cancel = cancel
+
+	c.lock.Lock()
+	if c.remotes == nil {
+		c.remotes = make(map[string]*remoteProvider)
+	}
+	c.remotes[name] = rp
+	c.lock.Unlock()
+
+	updates, err := provider.Watch(ctx)
+	if err != nil {
+		// no native watch support: fall back to polling on the configured
+		// interval.
+		go c.pollRemoteProvider(ctx, rp, ro.pollInterval)
+		return nil
+	}
+
+	go c.streamRemoteUpdates(ctx, rp, updates)
+	return nil
+}
+
+// RemoveRemoteProvider stops watching/polling the named provider.
+func (c *Config) RemoveRemoteProvider(name string) {
+	c.lock.Lock()
+	rp, ok := c.remotes[name]
+	if ok {
+		delete(c.remotes, name)
+	}
+	c.lock.Unlock()
+
+	if ok && rp.cancel != nil {
+		rp.cancel()
+	}
+}
+
+func (c *Config) streamRemoteUpdates(ctx context.Context, rp *remoteProvider, updates <-chan []byte) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case blob, ok := <-updates:
+			if !ok {
+				return
+			}
+			if err := c.mergeRemoteBlob(rp, blob); err != nil {
+				c.addError(err)
+			}
+		}
+	}
+}
+
+func (c *Config) pollRemoteProvider(ctx context.Context, rp *remoteProvider, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			blob, _, err := rp.source.Get(ctx)
+			if err != nil {
+				c.addError(fmt.Errorf("config: poll remote provider %q: %w", rp.name, err))
+				continue
+			}
+
+			// an empty blob means the provider has nothing new (eg. an
+			// HTTPProvider reporting 304 Not Modified via ETag) - skip the
+			// merge rather than feeding an empty decode into c.data.
+			if len(blob) == 0 {
+				continue
+			}
+
+			if err := c.mergeRemoteBlob(rp, blob); err != nil {
+				c.addError(err)
+			}
+		}
+	}
+}
+
+// mergeRemoteBlob runs the decrypt hook (if any), decodes blob with rp's
+// format driver and merges it into c.data the same way reloadFile merges
+// a changed local file, invalidating caches and dispatching OnChange.
+func (c *Config) mergeRemoteBlob(rp *remoteProvider, blob []byte) error {
+	if rp.decrypt != nil {
+		decrypted, err := rp.decrypt(blob)
+		if err != nil {
+			return fmt.Errorf("config: decrypt remote provider %q: %w", rp.name, err)
+		}
+		blob = decrypted
+	}
+
+	newData, err := c.decodeSourceCode(rp.format, blob)
+	if err != nil {
+		return fmt.Errorf("config: decode remote provider %q: %w", rp.name, err)
+	}
+
+	c.lock.Lock()
+	oldData := c.data
+	c.data = mergeStringMap(oldData, newData, true)
+	c.invalidateCaches()
+
+	var handlers map[string][]ChangeHandler
+	if c.watcher != nil {
+		handlers = collectChangeHandlers(c.watcher)
+	}
+	newSnapshot := c.data
+	c.lock.Unlock()
+
+	if handlers != nil {
+		dispatchChanges(handlers, oldData, newSnapshot)
+	}
+	return nil
+}
+
+/*************************************************************
+ * remote provider options
+ *************************************************************/
+
+const defaultPollInterval = 30 * time.Second
+
+// RemoteOption configures AddRemoteProvider.
+type RemoteOption func(*remoteOpts)
+
+type remoteOpts struct {
+	pollInterval time.Duration
+	decrypt      DecryptFunc
+}
+
+// WithPollInterval sets the interval used to poll providers that don't
+// support a native Watch. Ignored for providers whose Watch succeeds.
+func WithPollInterval(d time.Duration) RemoteOption {
+	return func(o *remoteOpts) {
+		o.pollInterval = d
+	}
+}
+
+// WithDecryptHook installs a DecryptFunc run on every fetched/streamed
+// blob before it's handed to the format decoder, so encrypted secrets
+// pulled from a KV store can be transparently decoded.
+func WithDecryptHook(fn DecryptFunc) RemoteOption {
+	return func(o *remoteOpts) {
+		o.decrypt = fn
+	}
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	consulapi "github.com/hashicorp/consul/api"
+)
+
+// consulRetryBackoff is the pause between blocking-query retries after a
+// transient Consul/network error, so an unreachable Consul doesn't turn
+// the watch goroutine into a tight retry loop.
+const consulRetryBackoff = 1 * time.Second
+
+// ConsulProvider is a RemoteProvider backed by a single Consul KV key.
+// Consul has no long-lived watch connection, so Watch is implemented with
+// blocking queries: each call blocks on the KV's ModifyIndex until it
+// changes, then returns, giving near-real-time updates without polling
+// on a fixed interval.
+type ConsulProvider struct {
+	Client *consulapi.Client
+	Key    string
+	Format string
+}
+
+// NewConsulProvider creates a ConsulProvider reading key from an already
+// connected client.
+func NewConsulProvider(client *consulapi.Client, key, format string) *ConsulProvider {
+	return &ConsulProvider{Client: client, Key: key, Format: format}
+}
+
+// Get fetches the current value of Key.
+func (p *ConsulProvider) Get(ctx context.Context) ([]byte, string, error) {
+	kv, _, err := p.Client.KV().Get(p.Key, (&consulapi.QueryOptions{}).WithContext(ctx))
+	if err != nil {
+		return nil, "", err
+	}
+
+	if kv == nil {
+		return nil, "", fmt.Errorf("config: consul key %q not found", p.Key)
+	}
+
+	return kv.Value, p.Format, nil
+}
+
+// Watch issues blocking queries against Key's ModifyIndex, emitting a new
+// value every time Consul reports a change.
+func (p *ConsulProvider) Watch(ctx context.Context) (<-chan []byte, error) {
+	out := make(chan []byte)
+
+	go func() {
+		defer close(out)
+
+		var lastIndex uint64
+		for {
+			opts := (&consulapi.QueryOptions{WaitIndex: lastIndex}).WithContext(ctx)
+
+			kv, meta, err := p.Client.KV().Get(p.Key, opts)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
+				// transient Consul/network error: back off before the
+				// next blocking query retry rather than tearing down the
+				// watch or spinning a tight retry loop.
+				select {
+				case <-time.After(consulRetryBackoff):
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+
+			if meta != nil {
+				lastIndex = meta.LastIndex
+			}
+
+			if kv == nil {
+				continue
+			}
+
+			select {
+			case out <- kv.Value:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
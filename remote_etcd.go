@@ -0,0 +1,63 @@
+package config
+
+import (
+	"context"
+	"fmt"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// EtcdProvider is a RemoteProvider backed by a single key in an etcd v3
+// cluster. It supports native watches, so AddRemoteProvider streams
+// updates directly from etcd's watch API instead of polling.
+type EtcdProvider struct {
+	Client *clientv3.Client
+	Key    string
+	Format string
+}
+
+// NewEtcdProvider creates an EtcdProvider reading key from an already
+// connected client.
+func NewEtcdProvider(client *clientv3.Client, key, format string) *EtcdProvider {
+	return &EtcdProvider{Client: client, Key: key, Format: format}
+}
+
+// Get fetches the current value of Key.
+func (p *EtcdProvider) Get(ctx context.Context) ([]byte, string, error) {
+	resp, err := p.Client.Get(ctx, p.Key)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(resp.Kvs) == 0 {
+		return nil, "", fmt.Errorf("config: etcd key %q not found", p.Key)
+	}
+
+	return resp.Kvs[0].Value, p.Format, nil
+}
+
+// Watch streams every subsequent value etcd reports for Key.
+func (p *EtcdProvider) Watch(ctx context.Context) (<-chan []byte, error) {
+	out := make(chan []byte)
+	wc := p.Client.Watch(ctx, p.Key)
+
+	go func() {
+		defer close(out)
+
+		for resp := range wc {
+			for _, ev := range resp.Events {
+				if ev.Kv == nil {
+					continue
+				}
+
+				select {
+				case out <- ev.Kv.Value:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
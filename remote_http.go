@@ -0,0 +1,79 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// HTTPProvider is a RemoteProvider backed by a plain HTTP(S) endpoint. It
+// has no native push support, so AddRemoteProvider polls it on the
+// configured interval, using If-None-Match/ETag to turn unchanged polls
+// into cheap 304s.
+type HTTPProvider struct {
+	URL    string
+	Format string
+	Client *http.Client
+
+	etag string
+}
+
+// NewHTTPProvider creates an HTTPProvider for url. format is the decoder
+// hint (eg. "json", "yaml") since most HTTP endpoints don't advertise one
+// the way a file extension would.
+func NewHTTPProvider(url, format string) *HTTPProvider {
+	return &HTTPProvider{URL: url, Format: format, Client: http.DefaultClient}
+}
+
+// Get fetches the current body from URL.
+func (p *HTTPProvider) Get(ctx context.Context) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.URL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if p.etag != "" {
+		req.Header.Set("If-None-Match", p.etag)
+	}
+
+	resp, err := p.client().Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, p.Format, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("config: GET %s: unexpected status %s", p.URL, resp.Status)
+	}
+
+	blob, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if tag := resp.Header.Get("ETag"); tag != "" {
+		p.etag = tag
+	}
+
+	return blob, p.Format, nil
+}
+
+// Watch has no native push support for a plain HTTP endpoint: return an
+// error so AddRemoteProvider falls back to polling Get on an interval.
+func (p *HTTPProvider) Watch(ctx context.Context) (<-chan []byte, error) {
+	return nil, errHTTPWatchUnsupported
+}
+
+func (p *HTTPProvider) client() *http.Client {
+	if p.Client != nil {
+		return p.Client
+	}
+	return http.DefaultClient
+}
+
+var errHTTPWatchUnsupported = fmt.Errorf("config: HTTPProvider does not support Watch, use WithPollInterval")
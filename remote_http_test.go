@@ -0,0 +1,89 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHTTPProvider_etagPolling(t *testing.T) {
+	hits := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`{"name":"app"}`))
+	}))
+	defer srv.Close()
+
+	p := NewHTTPProvider(srv.URL, "json")
+
+	blob, format, err := p.Get(context.Background())
+	if err != nil {
+		t.Fatalf("first Get error: %v", err)
+	}
+	if format != "json" || string(blob) != `{"name":"app"}` {
+		t.Fatalf("unexpected first response: %s / %s", format, blob)
+	}
+
+	blob, _, err = p.Get(context.Background())
+	if err != nil {
+		t.Fatalf("second Get error: %v", err)
+	}
+	if len(blob) != 0 {
+		t.Fatalf("want empty body on 304, got %s", blob)
+	}
+
+	if hits != 2 {
+		t.Fatalf("want 2 requests, got %d", hits)
+	}
+
+	if _, err := p.Watch(context.Background()); err == nil {
+		t.Fatalf("want Watch to report native watch is unsupported")
+	}
+}
+
+// TestAddRemoteProvider_pollSkipsUnchanged exercises pollRemoteProvider
+// end-to-end: once the server starts replying 304, HTTPProvider.Get
+// returns an empty blob and the poll loop must skip the merge instead of
+// feeding it to the format decoder (which would either error repeatedly
+// or wipe out c.data).
+func TestAddRemoteProvider_pollSkipsUnchanged(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`{"name":"app"}`))
+	}))
+	defer srv.Close()
+
+	c := New("test")
+	p := NewHTTPProvider(srv.URL, "json")
+
+	if err := c.AddRemoteProvider("app", p, "json", WithPollInterval(10*time.Millisecond)); err != nil {
+		t.Fatalf("AddRemoteProvider error: %v", err)
+	}
+	defer c.RemoveRemoteProvider("app")
+
+	// give the poll loop a few ticks to hit the 304 path.
+	time.Sleep(80 * time.Millisecond)
+
+	if err := c.Error(); err != nil {
+		t.Fatalf("want no error from repeated 304 polls, got: %v", err)
+	}
+
+	name, ok := c.Get("name")
+	if !ok || name != "app" {
+		t.Fatalf("want name=app still intact after unchanged polls, got %v ok=%v", name, ok)
+	}
+}
@@ -0,0 +1,280 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// debounceWait is the quiet period used to coalesce the burst of events
+// that editors like vim/goland generate for a single logical save
+// (remove + create, or several chmod/write events in a row).
+const debounceWait = 300 * time.Millisecond
+
+// ChangeHandler is called when a watched key path changes value.
+// newVal is nil when the key was removed, oldVal is nil when it's new.
+type ChangeHandler func(key string, newVal, oldVal interface{})
+
+// watcher holds the runtime state for Config.Watch. It's kept separate
+// from Config so zero-value Config instances that never call Watch don't
+// pay for it.
+type watcher struct {
+	fw   *fsnotify.Watcher
+	mu   sync.Mutex
+	done chan struct{}
+
+	// file -> pending debounce timer, one per file so an event on one
+	// file doesn't cancel another file's pending reload.
+	timers map[string]*time.Timer
+
+	// file -> format, so a changed path can be re-parsed with the driver
+	// it was originally loaded with.
+	files map[string]string
+
+	// dot-path -> registered callbacks
+	onChange map[string][]ChangeHandler
+}
+
+// Watch starts watching every file previously loaded via LoadFiles/LoadExists
+// for on-disk changes, debounces the burst of fsnotify events a single save
+// produces, reloads the changed file with its original decoder and atomically
+// swaps it into the merged data. Call StopWatch to release the watcher.
+//
+// Watch is a no-op if no files were loaded, or if it was already called.
+func (c *Config) Watch() error {
+	c.lock.Lock()
+	if c.watcher != nil {
+		c.lock.Unlock()
+		return nil
+	}
+
+	if len(c.loadedFiles) == 0 {
+		c.lock.Unlock()
+		return nil
+	}
+
+	fw, err := fsnotify.NewWatcher()
+	if err != nil {
+		c.lock.Unlock()
+		return err
+	}
+
+	w := &watcher{
+		fw:       fw,
+		done:     make(chan struct{}),
+		files:    make(map[string]string, len(c.loadedFiles)),
+		onChange: make(map[string][]ChangeHandler, len(c.pendingOnChange)),
+	}
+
+	// hand over any handler registered via OnChange before this Watch()
+	// call, instead of dropping it.
+	for key, fns := range c.pendingOnChange {
+		w.onChange[key] = append(w.onChange[key], fns...)
+	}
+	c.pendingOnChange = nil
+
+	for _, file := range c.loadedFiles {
+		w.files[file] = strings.Trim(filepath.Ext(file), ".")
+
+		if err := fw.Add(file); err != nil {
+			fw.Close()
+			c.lock.Unlock()
+			return err
+		}
+
+		// some editors replace the file (remove+create) instead of writing
+		// in place, so the dir must be watched too, else the watch on the
+		// now-deleted inode goes stale.
+		_ = fw.Add(filepath.Dir(file))
+	}
+
+	c.watcher = w
+	c.lock.Unlock()
+
+	go c.watchLoop(w)
+	return nil
+}
+
+// StopWatch stops the watcher started by Watch. It's safe to call even if
+// Watch was never called.
+func (c *Config) StopWatch() error {
+	c.lock.Lock()
+	w := c.watcher
+	c.watcher = nil
+	c.lock.Unlock()
+
+	if w == nil {
+		return nil
+	}
+
+	close(w.done)
+	return w.fw.Close()
+}
+
+// OnChange registers fn to be called whenever the value at key (dot-path,
+// same rules as Get) changes after a reload triggered by Watch. fn is
+// dispatched off the config's write lock, so it's safe to call back into
+// the Config from fn.
+func (c *Config) OnChange(key string, fn ChangeHandler) {
+	key = formatKey(key)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.watcher == nil {
+		// Watch hasn't been called yet: stash the handler so it's picked
+		// up once the watcher exists.
+		if c.pendingOnChange == nil {
+			c.pendingOnChange = make(map[string][]ChangeHandler)
+		}
+		c.pendingOnChange[key] = append(c.pendingOnChange[key], fn)
+		return
+	}
+
+	c.watcher.onChange[key] = append(c.watcher.onChange[key], fn)
+}
+
+func (c *Config) watchLoop(w *watcher) {
+	for {
+		select {
+		case <-w.done:
+			return
+		case event, ok := <-w.fw.Events:
+			if !ok {
+				return
+			}
+
+			if _, ok := w.files[event.Name]; !ok {
+				continue
+			}
+
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+				continue
+			}
+
+			c.debounceReload(w, event.Name)
+		case <-w.fw.Errors:
+			// best effort: a single broken watch shouldn't kill the loop
+		}
+	}
+}
+
+// debounceReload coalesces the several events a single save produces into
+// one reload, `debounceWait` after the last event for the file.
+func (c *Config) debounceReload(w *watcher, file string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if t := w.timers[file]; t != nil {
+		t.Stop()
+	}
+
+	if w.timers == nil {
+		w.timers = make(map[string]*time.Timer)
+	}
+
+	w.timers[file] = time.AfterFunc(debounceWait, func() {
+		c.reloadFile(w, file)
+	})
+}
+
+func (c *Config) reloadFile(w *watcher, file string) {
+	format := w.files[file]
+
+	blob, err := readFileContents(file)
+	if err != nil {
+		c.addError(err)
+		return
+	}
+
+	newData, err := c.decodeSourceCode(format, blob)
+	if err != nil {
+		c.addError(err)
+		return
+	}
+
+	c.lock.Lock()
+	oldData := c.data
+
+	// merge the re-parsed file over the existing data, same semantics as
+	// LoadFiles, then invalidate every getter cache so the next String()/
+	// Strings()/StringMap() call re-reads from c.data.
+	c.data = mergeStringMap(oldData, newData, true)
+	c.invalidateCaches()
+
+	handlers := collectChangeHandlers(w)
+	c.lock.Unlock()
+
+	dispatchChanges(handlers, oldData, c.data)
+}
+
+// collectChangeHandlers snapshots the registered handlers under the lock
+// so dispatch can run without holding it.
+func collectChangeHandlers(w *watcher) map[string][]ChangeHandler {
+	out := make(map[string][]ChangeHandler, len(w.onChange))
+	for k, v := range w.onChange {
+		out[k] = v
+	}
+	return out
+}
+
+// dispatchChanges diffs oldData/newData for every key path that has a
+// registered handler and fires it when the value differs.
+func dispatchChanges(handlers map[string][]ChangeHandler, oldData, newData map[string]interface{}) {
+	for key, fns := range handlers {
+		oldVal := lookupPath(oldData, key)
+		newVal := lookupPath(newData, key)
+
+		if reflect.DeepEqual(oldVal, newVal) {
+			continue
+		}
+
+		for _, fn := range fns {
+			fn(key, newVal, oldVal)
+		}
+	}
+}
+
+// readFileContents reads the full content of file with os.ReadFile - the
+// same bytes loadFile reads (via os.Open and ioutil.ReadAll) for the
+// initial LoadFiles/LoadExists pass.
+func readFileContents(file string) ([]byte, error) {
+	return os.ReadFile(file)
+}
+
+// lookupPath resolves a dot-path against a plain map, walking nested
+// json/toml/yaml maps and []interface{} arrays the way Config.Get does, but
+// without needing a *Config (and its lock).
+func lookupPath(data map[string]interface{}, key string) interface{} {
+	if data == nil {
+		return nil
+	}
+
+	keys := strings.Split(key, ".")
+
+	var item interface{} = data
+	for _, k := range keys {
+		switch typeData := item.(type) {
+		case map[string]interface{}: // decode from json,toml
+			item = typeData[k]
+		case map[interface{}]interface{}: // decode from yaml
+			item = typeData[k]
+		case []interface{}: // array
+			i, err := strconv.Atoi(k)
+			if err != nil || i < 0 || i >= len(typeData) {
+				return nil
+			}
+			item = typeData[i]
+		default:
+			return nil
+		}
+	}
+
+	return item
+}
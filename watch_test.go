@@ -0,0 +1,48 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestConfig_OnChange_beforeWatch verifies a handler registered via
+// OnChange before the first Watch() call still fires, instead of being
+// silently dropped when Watch() builds its onChange map.
+func TestConfig_OnChange_beforeWatch(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "app.json")
+
+	if err := os.WriteFile(file, []byte(`{"name":"app"}`), 0o644); err != nil {
+		t.Fatalf("write temp file: %v", err)
+	}
+
+	c := New("test")
+	if err := c.LoadFiles(file); err != nil {
+		t.Fatalf("LoadFiles error: %v", err)
+	}
+
+	changed := make(chan interface{}, 1)
+	c.OnChange("name", func(key string, newVal, oldVal interface{}) {
+		changed <- newVal
+	})
+
+	if err := c.Watch(); err != nil {
+		t.Fatalf("Watch error: %v", err)
+	}
+	defer c.StopWatch()
+
+	if err := os.WriteFile(file, []byte(`{"name":"app2"}`), 0o644); err != nil {
+		t.Fatalf("rewrite temp file: %v", err)
+	}
+
+	select {
+	case newVal := <-changed:
+		if newVal != "app2" {
+			t.Fatalf("want new value app2, got %v", newVal)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("OnChange handler registered before Watch() was never called")
+	}
+}